@@ -0,0 +1,187 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ConditionKind identifies a cross-field predicate attached to a Condition.
+type ConditionKind int
+
+const (
+	// RequiredIf requires the field when OtherField's value is one of Equals.
+	RequiredIf ConditionKind = iota
+	// RequiredUnless requires the field unless OtherField's value is one of Equals.
+	RequiredUnless
+	// ExcludedIf forbids a non-zero value for the field when OtherField's
+	// value is one of Equals.
+	ExcludedIf
+	// ExcludedUnless forbids a non-zero value for the field unless
+	// OtherField's value is one of Equals.
+	ExcludedUnless
+	// RequiredWith requires the field when any field named in OtherField
+	// (a comma-separated list) is non-zero.
+	RequiredWith
+	// RequiredWithAll requires the field when every field named in
+	// OtherField (a comma-separated list) is non-zero.
+	RequiredWithAll
+	// RequiredWithout requires the field when any field named in OtherField
+	// (a comma-separated list) is zero.
+	RequiredWithout
+	// RequiredWithoutAll requires the field when every field named in
+	// OtherField (a comma-separated list) is zero.
+	RequiredWithoutAll
+)
+
+// Condition is a cross-field predicate evaluated by ValidateStruct against
+// the parent struct of the field it is attached to. OtherField names a
+// sibling field; for the With/WithAll/Without/WithoutAll kinds it may be a
+// comma-separated list of sibling field names. Equals holds the sibling
+// value(s) that satisfy an If/Unless predicate and is unused otherwise.
+type Condition struct {
+	Kind       ConditionKind
+	OtherField string
+	Equals     []any
+}
+
+func (k ConditionKind) String() string {
+	switch k {
+	case RequiredIf:
+		return "required_if"
+	case RequiredUnless:
+		return "required_unless"
+	case ExcludedIf:
+		return "excluded_if"
+	case ExcludedUnless:
+		return "excluded_unless"
+	case RequiredWith:
+		return "required_with"
+	case RequiredWithAll:
+		return "required_with_all"
+	case RequiredWithout:
+		return "required_without"
+	case RequiredWithoutAll:
+		return "required_without_all"
+	default:
+		return "condition"
+	}
+}
+
+// checkConditions evaluates conditions against parent (the struct directly
+// containing the field being validated) and returns the rule name and error
+// of the first violated condition, if any. isZero reports whether the field
+// under validation currently holds its zero value.
+func checkConditions(conditions []Condition, parent reflect.Value, isZero bool) (string, error) {
+	for _, c := range conditions {
+		switch c.Kind {
+		case RequiredIf, RequiredUnless, RequiredWith, RequiredWithAll, RequiredWithout, RequiredWithoutAll:
+			if requirementTriggered(c, parent) && isZero {
+				return c.Kind.String(), &ValidationError{Code: "condition.required", Params: map[string]any{"kind": c.Kind.String()}}
+			}
+		case ExcludedIf, ExcludedUnless:
+			if exclusionTriggered(c, parent) && !isZero {
+				return c.Kind.String(), &ValidationError{Code: "condition.excluded", Params: map[string]any{"kind": c.Kind.String()}}
+			}
+		}
+	}
+	return "", nil
+}
+
+func requirementTriggered(c Condition, parent reflect.Value) bool {
+	switch c.Kind {
+	case RequiredIf:
+		return siblingEquals(parent, c.OtherField, c.Equals)
+	case RequiredUnless:
+		return !siblingEquals(parent, c.OtherField, c.Equals)
+	case RequiredWith:
+		return siblingsPresent(parent, c.OtherField, false)
+	case RequiredWithAll:
+		return siblingsPresent(parent, c.OtherField, true)
+	case RequiredWithout:
+		return !siblingsPresent(parent, c.OtherField, true)
+	case RequiredWithoutAll:
+		return !siblingsPresent(parent, c.OtherField, false)
+	}
+	return false
+}
+
+func exclusionTriggered(c Condition, parent reflect.Value) bool {
+	switch c.Kind {
+	case ExcludedIf:
+		return siblingEquals(parent, c.OtherField, c.Equals)
+	case ExcludedUnless:
+		return !siblingEquals(parent, c.OtherField, c.Equals)
+	}
+	return false
+}
+
+// siblingEquals reports whether the named sibling field's value equals one
+// of equals. A missing field or a field of an uncomparable type (slice, map,
+// func) never equals — the `==` below would otherwise panic on those types.
+// A nil entry in equals matches a nil pointer/interface sibling: fv.Interface()
+// would box it as a typed nil (e.g. (*string)(nil)), which is never == to the
+// untyped nil literal in equals, so that case is checked via fv.IsNil() instead.
+func siblingEquals(parent reflect.Value, name string, equals []any) bool {
+	fv, ok := lookupSibling(parent, strings.TrimSpace(name))
+	if !ok || !fv.CanInterface() || !fv.Comparable() {
+		return false
+	}
+	nilable := fv.Kind() == reflect.Pointer || fv.Kind() == reflect.Interface
+	val := fv.Interface()
+	for _, e := range equals {
+		if e == nil {
+			if nilable && fv.IsNil() {
+				return true
+			}
+			continue
+		}
+		if val == e {
+			return true
+		}
+	}
+	return false
+}
+
+// siblingsPresent checks the comma-separated field list in fields. When all
+// is true, every listed field must be non-zero for the result to be true;
+// otherwise any one non-zero field is enough.
+func siblingsPresent(parent reflect.Value, fields string, all bool) bool {
+	present, total := 0, 0
+	for _, name := range strings.Split(fields, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		total++
+		fv, ok := lookupSibling(parent, name)
+		if ok && !fv.IsZero() {
+			present++
+		}
+	}
+	if total == 0 {
+		return false
+	}
+	if all {
+		return present == total
+	}
+	return present > 0
+}
+
+// lookupSibling resolves name to a field on parent, dereferencing a single
+// level of pointer indirection so a nil pointer reads as the zero value.
+func lookupSibling(parent reflect.Value, name string) (reflect.Value, bool) {
+	if !parent.IsValid() || parent.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	fv := parent.FieldByName(name)
+	if !fv.IsValid() {
+		return reflect.Value{}, false
+	}
+	if fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			return fv, true
+		}
+		fv = fv.Elem()
+	}
+	return fv, true
+}
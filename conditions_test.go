@@ -0,0 +1,128 @@
+package validation
+
+import (
+	"testing"
+)
+
+type conditionTestSubject struct {
+	Kind string
+	Tags []string
+	Note string
+	A    string
+	B    string
+	Ptr  *string
+}
+
+func TestValidateStructRequiredIf(t *testing.T) {
+	v := conditionTestSubject{Kind: "special", Note: ""}
+	schema := &StructSchema{
+		Fields: map[string]FieldSchema{
+			"Note": {String: &StringValidationOptions{
+				Empty: true,
+				Conditions: []Condition{
+					{Kind: RequiredIf, OtherField: "Kind", Equals: []any{"special"}},
+				},
+			}},
+		},
+	}
+
+	errs := ValidateStruct(&v, schema)
+	if len(errs) != 1 || errs[0].Field != "Note" || errs[0].Rule != "required_if" {
+		t.Fatalf("expected a required_if error on Note, got: %v", errs)
+	}
+}
+
+func TestValidateStructRequiredIfUncomparableSiblingDoesNotPanic(t *testing.T) {
+	v := conditionTestSubject{Tags: []string{"x"}, Note: ""}
+	schema := &StructSchema{
+		Fields: map[string]FieldSchema{
+			"Note": {String: &StringValidationOptions{
+				Empty: true,
+				Conditions: []Condition{
+					{Kind: RequiredIf, OtherField: "Tags", Equals: []any{"x"}},
+				},
+			}},
+		},
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("ValidateStruct panicked on an uncomparable sibling field: %v", r)
+		}
+	}()
+
+	errs := ValidateStruct(&v, schema)
+	if len(errs) != 0 {
+		t.Fatalf("a slice-typed sibling can never equal a Condition value, expected no errors, got: %v", errs)
+	}
+}
+
+func TestValidateStructRequiredIfNilSiblingMatchesNilEquals(t *testing.T) {
+	v := conditionTestSubject{Ptr: nil, Note: ""}
+	schema := &StructSchema{
+		Fields: map[string]FieldSchema{
+			"Note": {String: &StringValidationOptions{
+				Empty: true,
+				Conditions: []Condition{
+					{Kind: RequiredIf, OtherField: "Ptr", Equals: []any{nil}},
+				},
+			}},
+		},
+	}
+
+	errs := ValidateStruct(&v, schema)
+	if len(errs) != 1 || errs[0].Field != "Note" || errs[0].Rule != "required_if" {
+		t.Fatalf("expected a required_if error on Note when the nil Ptr sibling matches a nil Equals entry, got: %v", errs)
+	}
+
+	set := "x"
+	v.Ptr = &set
+	errs = ValidateStruct(&v, schema)
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors once Ptr is non-nil, got: %v", errs)
+	}
+}
+
+func TestValidateStructRequiredWithAll(t *testing.T) {
+	v := conditionTestSubject{A: "set", B: ""}
+	schema := &StructSchema{
+		Fields: map[string]FieldSchema{
+			"Note": {String: &StringValidationOptions{
+				Empty: true,
+				Conditions: []Condition{
+					{Kind: RequiredWithAll, OtherField: "A, B"},
+				},
+			}},
+		},
+	}
+
+	// A is non-zero but B is zero, so "with all" should not trigger.
+	errs := ValidateStruct(&v, schema)
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors since not all of A,B are present, got: %v", errs)
+	}
+
+	v.B = "set"
+	errs = ValidateStruct(&v, schema)
+	if len(errs) != 1 || errs[0].Field != "Note" || errs[0].Rule != "required_with_all" {
+		t.Fatalf("expected a required_with_all error on Note once both A and B are set, got: %v", errs)
+	}
+}
+
+func TestValidateStructExcludedIf(t *testing.T) {
+	v := conditionTestSubject{Kind: "special", Note: "present"}
+	schema := &StructSchema{
+		Fields: map[string]FieldSchema{
+			"Note": {String: &StringValidationOptions{
+				Conditions: []Condition{
+					{Kind: ExcludedIf, OtherField: "Kind", Equals: []any{"special"}},
+				},
+			}},
+		},
+	}
+
+	errs := ValidateStruct(&v, schema)
+	if len(errs) != 1 || errs[0].Field != "Note" || errs[0].Rule != "excluded_if" {
+		t.Fatalf("expected an excluded_if error on Note, got: %v", errs)
+	}
+}
@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"time"
+)
+
+type (
+	DurationValidationOptions struct {
+		Null        bool          // Allow null. Default: false, will raise an error if the duration is null
+		Empty       bool          // Allow zero duration. Default: false, will raise an error if the duration is zero
+		Min         time.Duration // Minimum duration. Default: 0
+		Max         time.Duration // Maximum duration. Default: 0
+		NonNegative bool          // Do not allow a negative duration. Default: false, will raise an error if the duration is negative
+		// HasMin/HasMax activate Min/Max, including zero and negative
+		// bounds (e.g. Max: 0 to cap at "must not be positive"). Without
+		// them, Min/Max are not checked, unless LegacyZeroMeansUnset is set
+		// (see below). NumericValidationOptions follows the same rule.
+		HasMin   bool
+		HasMax   bool
+		Extended []func(value *time.Duration) error
+		// Conditions are sibling-field predicates (RequiredIf, ExcludedUnless, etc.)
+		// evaluated only by ValidateStruct, which has access to the parent struct.
+		Conditions []Condition
+		// Deprecated: LegacyZeroMeansUnset restores the pre-fix behavior
+		// where Min/Max only activated when greater than zero, silently
+		// ignoring zero and negative bounds, and HasMin/HasMax were not
+		// consulted. Set it while migrating call sites to HasMin/HasMax;
+		// this flag will be removed in a future release.
+		LegacyZeroMeansUnset bool
+	}
+)
+
+// ValidateDuration validates an input duration against the duration validation options
+func ValidateDuration(value *time.Duration, opts *DurationValidationOptions) error {
+
+	// If options were not set, this duration is valid
+	// If value is nil and the Null option is false, we raise an error
+	// If value is zero and the Empty option is false, we raise an error
+	if opts == nil {
+		return nil
+	}
+	if value == nil {
+		if !opts.Null {
+			return &ValidationError{Code: "duration.required"}
+		}
+		return nil
+	}
+	if *value == 0 {
+		if !opts.Empty {
+			return &ValidationError{Code: "duration.empty"}
+		}
+	}
+	if opts.NonNegative && *value < 0 {
+		return &ValidationError{Code: "duration.negative", Params: map[string]any{"actual": *value}}
+	}
+
+	minActive, maxActive := opts.HasMin, opts.HasMax
+	if opts.LegacyZeroMeansUnset {
+		minActive, maxActive = opts.Min > 0, opts.Max > 0
+	}
+	if minActive && *value < opts.Min {
+		return &ValidationError{Code: "duration.below_min", Params: map[string]any{"min": opts.Min, "actual": *value}}
+	}
+	if maxActive && *value > opts.Max {
+		return &ValidationError{Code: "duration.above_max", Params: map[string]any{"max": opts.Max, "actual": *value}}
+	}
+	for _, f := range opts.Extended {
+		if err := f(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ParseAndValidateDuration parses s using time.ParseDuration and validates
+// the result against the duration validation options
+func ParseAndValidateDuration(s string, opts *DurationValidationOptions) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, &ValidationError{Code: "duration.invalid", Params: map[string]any{"input": s, "cause": err.Error()}}
+	}
+	if err := ValidateDuration(&d, opts); err != nil {
+		return 0, err
+	}
+	return d, nil
+}
@@ -0,0 +1,88 @@
+package validation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateDurationNilOptionsAlwaysValid(t *testing.T) {
+	d := time.Duration(0)
+	if err := ValidateDuration(&d, nil); err != nil {
+		t.Fatalf("expected nil opts to always validate, got: %v", err)
+	}
+}
+
+func TestValidateDurationRequired(t *testing.T) {
+	err := ValidateDuration(nil, &DurationValidationOptions{})
+	if ve, ok := err.(*ValidationError); !ok || ve.Code != "duration.required" {
+		t.Fatalf("expected duration.required, got: %v", err)
+	}
+
+	if err := ValidateDuration(nil, &DurationValidationOptions{Null: true}); err != nil {
+		t.Fatalf("expected nil value to validate when Null is true, got: %v", err)
+	}
+}
+
+func TestValidateDurationEmpty(t *testing.T) {
+	d := time.Duration(0)
+	err := ValidateDuration(&d, &DurationValidationOptions{})
+	if ve, ok := err.(*ValidationError); !ok || ve.Code != "duration.empty" {
+		t.Fatalf("expected duration.empty, got: %v", err)
+	}
+
+	if err := ValidateDuration(&d, &DurationValidationOptions{Empty: true}); err != nil {
+		t.Fatalf("expected zero duration to validate when Empty is true, got: %v", err)
+	}
+}
+
+func TestValidateDurationNonNegative(t *testing.T) {
+	d := -1 * time.Minute
+	err := ValidateDuration(&d, &DurationValidationOptions{Empty: true, NonNegative: true})
+	if ve, ok := err.(*ValidationError); !ok || ve.Code != "duration.negative" {
+		t.Fatalf("expected duration.negative, got: %v", err)
+	}
+}
+
+func TestValidateDurationMinMaxRequireHasMinHasMax(t *testing.T) {
+	d := 5 * time.Minute
+
+	// Max: 0 without HasMax must not silently cap at zero.
+	if err := ValidateDuration(&d, &DurationValidationOptions{Empty: true, Max: 0}); err != nil {
+		t.Fatalf("expected an inactive Max:0 to be ignored, got: %v", err)
+	}
+
+	err := ValidateDuration(&d, &DurationValidationOptions{Empty: true, Max: 0, HasMax: true})
+	if ve, ok := err.(*ValidationError); !ok || ve.Code != "duration.above_max" {
+		t.Fatalf("expected duration.above_max once HasMax activates Max:0, got: %v", err)
+	}
+
+	opts := &DurationValidationOptions{Empty: true, Min: time.Minute, HasMin: true}
+	if err := ValidateDuration(&d, opts); err != nil {
+		t.Fatalf("expected 5m to satisfy a 1m minimum, got: %v", err)
+	}
+	short := 30 * time.Second
+	err = ValidateDuration(&short, opts)
+	if ve, ok := err.(*ValidationError); !ok || ve.Code != "duration.below_min" {
+		t.Fatalf("expected duration.below_min, got: %v", err)
+	}
+}
+
+func TestValidateDurationLegacyZeroMeansUnset(t *testing.T) {
+	d := 5 * time.Minute
+	opts := &DurationValidationOptions{Empty: true, Max: 0, HasMax: true, LegacyZeroMeansUnset: true}
+	if err := ValidateDuration(&d, opts); err != nil {
+		t.Fatalf("expected LegacyZeroMeansUnset to ignore a zero Max even with HasMax set, got: %v", err)
+	}
+}
+
+func TestParseAndValidateDuration(t *testing.T) {
+	d, err := ParseAndValidateDuration("5m", &DurationValidationOptions{Empty: true})
+	if err != nil || d != 5*time.Minute {
+		t.Fatalf("expected 5m to parse cleanly, got %v, %v", d, err)
+	}
+
+	_, err = ParseAndValidateDuration("not-a-duration", &DurationValidationOptions{})
+	if ve, ok := err.(*ValidationError); !ok || ve.Code != "duration.invalid" {
+		t.Fatalf("expected duration.invalid for an unparseable string, got: %v", err)
+	}
+}
@@ -0,0 +1,268 @@
+package validation
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	ssd "github.com/shopspring/decimal"
+)
+
+type (
+	// Rule is a composable validation check against a single value of type T.
+	// It is the building block of the rule-pipeline API: prebuilt rules
+	// below compose with Chain, and FieldValidation bundles them with a
+	// value for use with Validate. This complements rather than replaces
+	// ValidateString/ValidateNumeric/ValidateTime/ValidateDecimal, which
+	// remain the tag-free option-struct API for single values.
+	Rule[T any] interface {
+		Check(value *T) error
+	}
+
+	// RuleFunc adapts a plain function to the Rule interface.
+	RuleFunc[T any] func(value *T) error
+
+	// namedRuleFunc is a RuleFunc that also reports a stable rule name, so
+	// Validate can populate FieldError.Rule the same way ValidateStruct does.
+	namedRuleFunc[T any] struct {
+		name string
+		fn   func(value *T) error
+	}
+
+	// namedRule is implemented by rules that report a stable rule name.
+	namedRule interface {
+		RuleName() string
+	}
+
+	// FieldValidation bundles a value with the rules that should run against
+	// it for use with Validate. Tag is reported as FieldError.Field.
+	FieldValidation struct {
+		Tag   string
+		Value any
+		Rules []Rule[any]
+	}
+)
+
+func (f RuleFunc[T]) Check(value *T) error { return f(value) }
+
+func (r namedRuleFunc[T]) Check(value *T) error { return r.fn(value) }
+func (r namedRuleFunc[T]) RuleName() string     { return r.name }
+
+// Chain combines rules into a single Rule that runs them in order and stops
+// at the first failure, the same short-circuit behavior as the Extended
+// hooks on the option-struct validators.
+func Chain[T any](rules ...Rule[T]) Rule[T] {
+	return RuleFunc[T](func(value *T) error {
+		for _, r := range rules {
+			if err := r.Check(value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Validate runs each FieldValidation's rules against its value, stopping at
+// the first failing rule per field, and collects the failures of all fields
+// into a ValidationErrors the same way ValidateStruct does.
+func Validate(fields ...FieldValidation) ValidationErrors {
+	var errs ValidationErrors
+	for _, f := range fields {
+		for _, r := range f.Rules {
+			v := f.Value
+			err := r.Check(&v)
+			if err == nil {
+				continue
+			}
+			rule := "rule"
+			if nr, ok := r.(namedRule); ok {
+				rule = nr.RuleName()
+			}
+			errs = append(errs, &FieldError{Field: f.Tag, Rule: rule, Message: err.Error(), Value: f.Value})
+			break
+		}
+	}
+	return errs
+}
+
+func asString(value *any) (string, bool) {
+	if value == nil || *value == nil {
+		return "", false
+	}
+	s, ok := (*value).(string)
+	return s, ok
+}
+
+func asNumeric[T NumericConstraint](value *any) (T, bool) {
+	var zero T
+	if value == nil || *value == nil {
+		return zero, false
+	}
+	v, ok := (*value).(T)
+	return v, ok
+}
+
+// errNotString is returned by the string-typed rules when the value handed
+// to Check is not a string at all.
+func errNotString() error {
+	return &ValidationError{Code: "rule.type_mismatch", Params: map[string]any{"expected": "string"}}
+}
+
+// NonEmptyString rejects a value that is not a string, or an empty string.
+func NonEmptyString() Rule[any] {
+	return namedRuleFunc[any]{"nonempty", func(value *any) error {
+		s, ok := asString(value)
+		if !ok {
+			return errNotString()
+		}
+		if s == "" {
+			return &ValidationError{Code: "rule.nonempty"}
+		}
+		return nil
+	}}
+}
+
+// MinLength rejects a string shorter than n runes.
+func MinLength(n int) Rule[any] {
+	return namedRuleFunc[any]{"minlength", func(value *any) error {
+		s, ok := asString(value)
+		if !ok {
+			return errNotString()
+		}
+		if ln := len([]rune(s)); ln < n {
+			return &ValidationError{Code: "rule.minlength", Params: map[string]any{"min": n, "actual": ln}}
+		}
+		return nil
+	}}
+}
+
+// MaxLength rejects a string longer than n runes.
+func MaxLength(n int) Rule[any] {
+	return namedRuleFunc[any]{"maxlength", func(value *any) error {
+		s, ok := asString(value)
+		if !ok {
+			return errNotString()
+		}
+		if ln := len([]rune(s)); ln > n {
+			return &ValidationError{Code: "rule.maxlength", Params: map[string]any{"max": n, "actual": ln}}
+		}
+		return nil
+	}}
+}
+
+// Matches rejects a string that does not match re.
+func Matches(re *regexp.Regexp) Rule[any] {
+	return namedRuleFunc[any]{"matches", func(value *any) error {
+		s, ok := asString(value)
+		if !ok {
+			return errNotString()
+		}
+		if !re.MatchString(s) {
+			return &ValidationError{Code: "rule.matches", Params: map[string]any{"pattern": re.String()}}
+		}
+		return nil
+	}}
+}
+
+// Email rejects a string that is not a valid e-mail address, reusing
+// ValidateEmail's pattern.
+func Email() Rule[any] {
+	return namedRuleFunc[any]{"email", func(value *any) error {
+		s, ok := asString(value)
+		if !ok {
+			return errNotString()
+		}
+		return ValidateEmail(&s)
+	}}
+}
+
+// URL rejects a string that is not an absolute URL with a scheme and host.
+func URL() Rule[any] {
+	return namedRuleFunc[any]{"url", func(value *any) error {
+		s, ok := asString(value)
+		if !ok {
+			return errNotString()
+		}
+		u, err := url.Parse(s)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return &ValidationError{Code: "rule.url", Params: map[string]any{"actual": s}}
+		}
+		return nil
+	}}
+}
+
+// OneOf rejects a value that is not equal to one of options.
+func OneOf[T comparable](options ...T) Rule[any] {
+	return namedRuleFunc[any]{"oneof", func(value *any) error {
+		if value != nil {
+			if v, ok := (*value).(T); ok {
+				for _, o := range options {
+					if v == o {
+						return nil
+					}
+				}
+			}
+		}
+		return &ValidationError{Code: "rule.oneof", Params: map[string]any{"options": options}}
+	}}
+}
+
+// NotBlank rejects a string that is empty once leading/trailing whitespace
+// is trimmed, unlike NonEmptyString which allows an all-whitespace string.
+func NotBlank() Rule[any] {
+	return namedRuleFunc[any]{"notblank", func(value *any) error {
+		s, ok := asString(value)
+		if !ok {
+			return errNotString()
+		}
+		if strings.TrimSpace(s) == "" {
+			return &ValidationError{Code: "rule.notblank"}
+		}
+		return nil
+	}}
+}
+
+// GreaterThan rejects a numeric value that is not strictly greater than min.
+func GreaterThan[T NumericConstraint](min T) Rule[any] {
+	return namedRuleFunc[any]{"greaterthan", func(value *any) error {
+		v, ok := asNumeric[T](value)
+		if !ok {
+			return &ValidationError{Code: "rule.type_mismatch", Params: map[string]any{"expected": "numeric"}}
+		}
+		if v <= min {
+			return &ValidationError{Code: "rule.greaterthan", Params: map[string]any{"min": min, "actual": v}}
+		}
+		return nil
+	}}
+}
+
+// Between rejects a numeric value outside the inclusive [min, max] range.
+func Between[T NumericConstraint](min, max T) Rule[any] {
+	return namedRuleFunc[any]{"between", func(value *any) error {
+		v, ok := asNumeric[T](value)
+		if !ok {
+			return &ValidationError{Code: "rule.type_mismatch", Params: map[string]any{"expected": "numeric"}}
+		}
+		if v < min || v > max {
+			return &ValidationError{Code: "rule.between", Params: map[string]any{"min": min, "max": max, "actual": v}}
+		}
+		return nil
+	}}
+}
+
+// PositiveDecimal rejects a decimal.Decimal that is not strictly positive.
+func PositiveDecimal() Rule[any] {
+	return namedRuleFunc[any]{"positivedecimal", func(value *any) error {
+		if value == nil || *value == nil {
+			return &ValidationError{Code: "rule.type_mismatch", Params: map[string]any{"expected": "decimal"}}
+		}
+		d, ok := (*value).(ssd.Decimal)
+		if !ok {
+			return &ValidationError{Code: "rule.type_mismatch", Params: map[string]any{"expected": "decimal"}}
+		}
+		if !d.GreaterThan(ssd.NewFromInt(0)) {
+			return &ValidationError{Code: "rule.positivedecimal", Params: map[string]any{"actual": d}}
+		}
+		return nil
+	}}
+}
@@ -0,0 +1,163 @@
+package validation
+
+import (
+	"regexp"
+	"testing"
+
+	ssd "github.com/shopspring/decimal"
+)
+
+func TestChainStopsAtFirstFailure(t *testing.T) {
+	calls := 0
+	countingRule := RuleFunc[any](func(value *any) error {
+		calls++
+		return nil
+	})
+	r := Chain[any](NonEmptyString(), countingRule)
+
+	v := any("")
+	if err := r.Check(&v); err == nil {
+		t.Fatal("expected NonEmptyString to fail on an empty string")
+	}
+	if calls != 0 {
+		t.Fatalf("expected Chain to short-circuit before the second rule, but it ran %d times", calls)
+	}
+}
+
+func TestValidatePipelineStopsAtFirstFailingRulePerField(t *testing.T) {
+	errs := Validate(
+		FieldValidation{Tag: "name", Value: "", Rules: []Rule[any]{NonEmptyString(), MinLength(3)}},
+		FieldValidation{Tag: "age", Value: 42, Rules: []Rule[any]{GreaterThan(0)}},
+	)
+	if len(errs) != 1 {
+		t.Fatalf("expected exactly one field error, got: %v", errs)
+	}
+	if errs[0].Field != "name" || errs[0].Rule != "nonempty" {
+		t.Fatalf("expected a nonempty error on name, got: %v", errs[0])
+	}
+}
+
+func TestNonEmptyString(t *testing.T) {
+	var notAString any = 5
+	if err := NonEmptyString().Check(&notAString); err == nil {
+		t.Fatal("expected an error for a non-string value")
+	}
+	var empty any = ""
+	if err := NonEmptyString().Check(&empty); err == nil {
+		t.Fatal("expected an error for an empty string")
+	}
+	var ok any = "hello"
+	if err := NonEmptyString().Check(&ok); err != nil {
+		t.Fatalf("expected no error for a non-empty string, got: %v", err)
+	}
+}
+
+func TestMinMaxLength(t *testing.T) {
+	short := any("ab")
+	if err := MinLength(3).Check(&short); err == nil {
+		t.Fatal("expected an error for a string shorter than the minimum")
+	}
+	long := any("abcd")
+	if err := MaxLength(3).Check(&long); err == nil {
+		t.Fatal("expected an error for a string longer than the maximum")
+	}
+	ok := any("abc")
+	if err := MinLength(3).Check(&ok); err != nil {
+		t.Fatalf("expected no error at the minimum length, got: %v", err)
+	}
+	if err := MaxLength(3).Check(&ok); err != nil {
+		t.Fatalf("expected no error at the maximum length, got: %v", err)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	re := regexp.MustCompile(`^[0-9]+$`)
+	bad := any("abc")
+	if err := Matches(re).Check(&bad); err == nil {
+		t.Fatal("expected an error for a non-matching string")
+	}
+	good := any("123")
+	if err := Matches(re).Check(&good); err != nil {
+		t.Fatalf("expected no error for a matching string, got: %v", err)
+	}
+}
+
+func TestEmailRule(t *testing.T) {
+	bad := any("not-an-email")
+	if err := Email().Check(&bad); err == nil {
+		t.Fatal("expected an error for an invalid email")
+	}
+	good := any("user@example.com")
+	if err := Email().Check(&good); err != nil {
+		t.Fatalf("expected no error for a valid email, got: %v", err)
+	}
+}
+
+func TestURLRule(t *testing.T) {
+	bad := any("not a url")
+	if err := URL().Check(&bad); err == nil {
+		t.Fatal("expected an error for an invalid URL")
+	}
+	good := any("https://example.com/path")
+	if err := URL().Check(&good); err != nil {
+		t.Fatalf("expected no error for a valid URL, got: %v", err)
+	}
+}
+
+func TestOneOf(t *testing.T) {
+	rule := OneOf("red", "green", "blue")
+	bad := any("purple")
+	if err := rule.Check(&bad); err == nil {
+		t.Fatal("expected an error for a value outside the allowed set")
+	}
+	good := any("green")
+	if err := rule.Check(&good); err != nil {
+		t.Fatalf("expected no error for an allowed value, got: %v", err)
+	}
+}
+
+func TestNotBlank(t *testing.T) {
+	blank := any("   ")
+	if err := NotBlank().Check(&blank); err == nil {
+		t.Fatal("expected an error for a whitespace-only string")
+	}
+	good := any(" ok ")
+	if err := NotBlank().Check(&good); err != nil {
+		t.Fatalf("expected no error for a non-blank string, got: %v", err)
+	}
+}
+
+func TestGreaterThan(t *testing.T) {
+	rule := GreaterThan(10)
+	low := any(10)
+	if err := rule.Check(&low); err == nil {
+		t.Fatal("expected an error for a value equal to the bound")
+	}
+	high := any(11)
+	if err := rule.Check(&high); err != nil {
+		t.Fatalf("expected no error for a value above the bound, got: %v", err)
+	}
+}
+
+func TestBetween(t *testing.T) {
+	rule := Between(1, 5)
+	outside := any(6)
+	if err := rule.Check(&outside); err == nil {
+		t.Fatal("expected an error for a value outside the range")
+	}
+	inside := any(3)
+	if err := rule.Check(&inside); err != nil {
+		t.Fatalf("expected no error for a value inside the range, got: %v", err)
+	}
+}
+
+func TestPositiveDecimal(t *testing.T) {
+	zero := any(ssd.NewFromInt(0))
+	if err := PositiveDecimal().Check(&zero); err == nil {
+		t.Fatal("expected an error for a zero decimal")
+	}
+	positive := any(ssd.NewFromInt(1))
+	if err := PositiveDecimal().Check(&positive); err != nil {
+		t.Fatalf("expected no error for a positive decimal, got: %v", err)
+	}
+}
@@ -0,0 +1,557 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	ssd "github.com/shopspring/decimal"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	decimalType  = reflect.TypeOf(ssd.Decimal{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+type (
+	// FieldError describes a single field-level validation failure produced
+	// by ValidateStruct.
+	FieldError struct {
+		Field   string // dotted field path, e.g. "Address.Zip"
+		Rule    string // failing rule, e.g. "min", "max", "nospaces"
+		Message string // human readable message
+		Value   any    // offending value, nil if the field itself was nil
+	}
+
+	// ValidationErrors is a collection of FieldError entries produced by
+	// ValidateStruct. Unlike the single-value Validate* functions, which
+	// return on the first violation, ValidateStruct collects every
+	// violation so callers can report all of them at once (e.g. to render
+	// per-field errors on a frontend).
+	ValidationErrors []*FieldError
+
+	// FieldSchema declares the validation options for a single struct field
+	// when building a StructSchema programmatically instead of relying on
+	// `validate:"..."` struct tags.
+	FieldSchema struct {
+		String   *StringValidationOptions
+		Int      *NumericValidationOptions[int64]
+		Uint     *NumericValidationOptions[uint64]
+		Float    *NumericValidationOptions[float64]
+		Time     *TimeValidationOptions
+		Decimal  *DecimalValidationOptions
+		Duration *DurationValidationOptions
+	}
+
+	// StructSchema maps a dotted field path (e.g. "Address.Zip") to the
+	// FieldSchema that should be applied to it. A field absent from Fields
+	// falls back to its `validate:"..."` struct tag, if any.
+	StructSchema struct {
+		Fields map[string]FieldSchema
+	}
+)
+
+// Error implements the error interface for a single field failure.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s %s", e.Field, e.Message)
+}
+
+// Error joins every field failure into a single message. Callers that want
+// to render failures individually should range over e instead.
+func (e ValidationErrors) Error() string {
+	if len(e) == 0 {
+		return ""
+	}
+	parts := make([]string, len(e))
+	for i, fe := range e {
+		parts[i] = fe.Error()
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Unwrap exposes the individual field failures to errors.Is/errors.As.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, fe := range e {
+		errs[i] = fe
+	}
+	return errs
+}
+
+// ValidateStruct walks v via reflection and validates each field either
+// against the FieldSchema registered for its dotted path in schema, or
+// against a `validate:"..."` struct tag when schema has no entry for that
+// path. v must be a struct or a pointer to a struct; any other kind (or a
+// nil pointer) returns a nil ValidationErrors.
+//
+// Nested structs, slices, arrays, maps and pointers are walked recursively;
+// the field path accumulates with dot and index notation, e.g.
+// "Items.0.Name" or "Metadata.owner". time.Time, decimal.Decimal and
+// time.Duration values are treated as leaves rather than recursed into.
+//
+// Any Conditions set on a field's options are evaluated against the struct
+// that directly contains the field, so RequiredIf/ExcludedUnless/etc. can
+// reference sibling fields. The single-value Validate* functions ignore
+// Conditions since they have no such context.
+func ValidateStruct(v any, schema *StructSchema) ValidationErrors {
+	var errs ValidationErrors
+	if v == nil {
+		return errs
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return errs
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return errs
+	}
+	validateStructValue(rv, "", schema, &errs)
+	return errs
+}
+
+func validateStructValue(rv reflect.Value, prefix string, schema *StructSchema, errs *ValidationErrors) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		path := sf.Name
+		if prefix != "" {
+			path = prefix + "." + sf.Name
+		}
+
+		var fs *FieldSchema
+		if schema != nil {
+			if found, ok := schema.Fields[path]; ok {
+				fs = &found
+			}
+		}
+
+		validateField(rv, rv.Field(i), sf, path, fs, schema, errs)
+	}
+}
+
+func validateField(parent, fv reflect.Value, sf reflect.StructField, path string, fs *FieldSchema, schema *StructSchema, errs *ValidationErrors) {
+	isNilPtr := false
+	for fv.Kind() == reflect.Pointer {
+		if fv.IsNil() {
+			// fv.Elem() on a nil pointer is an invalid Value, which would
+			// panic on Type()/Kind() below. Substitute the pointee's zero
+			// value so dispatch still resolves to the right leaf/kind case
+			// and Null/Conditions get evaluated instead of silently skipped.
+			isNilPtr = true
+			fv = reflect.Zero(fv.Type().Elem())
+			break
+		}
+		fv = fv.Elem()
+	}
+
+	switch fv.Type() {
+	case durationType:
+		opts := durationOptionsFor(sf, fs)
+		if opts == nil {
+			return
+		}
+		var dv *time.Duration
+		if !isNilPtr {
+			d := fv.Interface().(time.Duration)
+			dv = &d
+		}
+		isZero := isNilPtr || fv.IsZero()
+		if rule, err := checkConditions(opts.Conditions, parent, isZero); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		} else if rule, err := checkDuration(dv, opts); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		}
+		return
+	case timeType:
+		opts := timeOptionsFor(sf, fs)
+		if opts == nil {
+			return
+		}
+		var tv *time.Time
+		if !isNilPtr {
+			t := fv.Interface().(time.Time)
+			tv = &t
+		}
+		isZero := isNilPtr || fv.IsZero()
+		if rule, err := checkConditions(opts.Conditions, parent, isZero); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		} else if rule, err := checkTime(tv, opts); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		}
+		return
+	case decimalType:
+		opts := decimalOptionsFor(sf, fs)
+		if opts == nil {
+			return
+		}
+		var dv *ssd.Decimal
+		if !isNilPtr {
+			d := fv.Interface().(ssd.Decimal)
+			dv = &d
+		}
+		isZero := isNilPtr || fv.IsZero()
+		if rule, err := checkConditions(opts.Conditions, parent, isZero); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		} else if rule, err := checkDecimal(dv, opts); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		}
+		return
+	}
+
+	switch fv.Kind() {
+	case reflect.Struct:
+		if isNilPtr {
+			return
+		}
+		validateStructValue(fv, path, schema, errs)
+
+	case reflect.Slice, reflect.Array:
+		if isNilPtr {
+			return
+		}
+		for i := 0; i < fv.Len(); i++ {
+			validateField(parent, fv.Index(i), sf, fmt.Sprintf("%s.%d", path, i), fs, schema, errs)
+		}
+
+	case reflect.Map:
+		if isNilPtr {
+			return
+		}
+		for _, k := range fv.MapKeys() {
+			validateField(parent, fv.MapIndex(k), sf, fmt.Sprintf("%s.%v", path, k.Interface()), fs, schema, errs)
+		}
+
+	case reflect.String:
+		opts := stringOptionsFor(sf, fs)
+		if opts == nil {
+			return
+		}
+		var sv *string
+		if !isNilPtr {
+			s := fv.String()
+			sv = &s
+		}
+		isZero := isNilPtr || fv.IsZero()
+		if rule, err := checkConditions(opts.Conditions, parent, isZero); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		} else if rule, err := checkString(sv, opts); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		opts := intOptionsFor(sf, fs)
+		if opts == nil {
+			return
+		}
+		var nv *int64
+		if !isNilPtr {
+			n := fv.Int()
+			nv = &n
+		}
+		isZero := isNilPtr || fv.IsZero()
+		if rule, err := checkConditions(opts.Conditions, parent, isZero); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		} else if rule, err := checkNumeric(nv, opts); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		opts := uintOptionsFor(sf, fs)
+		if opts == nil {
+			return
+		}
+		var nv *uint64
+		if !isNilPtr {
+			n := fv.Uint()
+			nv = &n
+		}
+		isZero := isNilPtr || fv.IsZero()
+		if rule, err := checkConditions(opts.Conditions, parent, isZero); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		} else if rule, err := checkNumeric(nv, opts); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		}
+
+	case reflect.Float32, reflect.Float64:
+		opts := floatOptionsFor(sf, fs)
+		if opts == nil {
+			return
+		}
+		var nv *float64
+		if !isNilPtr {
+			n := fv.Float()
+			nv = &n
+		}
+		isZero := isNilPtr || fv.IsZero()
+		if rule, err := checkConditions(opts.Conditions, parent, isZero); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		} else if rule, err := checkNumeric(nv, opts); err != nil {
+			addFieldError(errs, path, rule, err, fv, isNilPtr)
+		}
+	}
+}
+
+func addFieldError(errs *ValidationErrors, path, rule string, err error, fv reflect.Value, isNilPtr bool) {
+	fe := &FieldError{Field: path, Rule: rule, Message: err.Error()}
+	if !isNilPtr && fv.IsValid() && fv.CanInterface() {
+		fe.Value = fv.Interface()
+	}
+	*errs = append(*errs, fe)
+}
+
+// checkString runs ValidateString and derives the failing rule from the
+// returned ValidationError's Code.
+func checkString(value *string, opts *StringValidationOptions) (string, error) {
+	err := ValidateString(value, opts)
+	return ruleFromError(err), err
+}
+
+// checkNumeric runs ValidateNumeric and derives the failing rule from the
+// returned ValidationError's Code.
+func checkNumeric[T NumericConstraint](value *T, opts *NumericValidationOptions[T]) (string, error) {
+	err := ValidateNumeric(value, opts)
+	return ruleFromError(err), err
+}
+
+// checkTime runs ValidateTime and derives the failing rule from the
+// returned ValidationError's Code.
+func checkTime(value *time.Time, opts *TimeValidationOptions) (string, error) {
+	err := ValidateTime(value, opts)
+	return ruleFromError(err), err
+}
+
+// checkDecimal runs ValidateDecimal and derives the failing rule from the
+// returned ValidationError's Code.
+func checkDecimal(value *ssd.Decimal, opts *DecimalValidationOptions) (string, error) {
+	err := ValidateDecimal(value, opts)
+	return ruleFromError(err), err
+}
+
+// checkDuration runs ValidateDuration and derives the failing rule from the
+// returned ValidationError's Code.
+func checkDuration(value *time.Duration, opts *DurationValidationOptions) (string, error) {
+	err := ValidateDuration(value, opts)
+	return ruleFromError(err), err
+}
+
+// ruleFromError maps a ValidationError's "<type>.<reason>" Code to the short
+// rule names ValidateStruct has always reported (e.g. "min", "max"). Errors
+// that are not a *ValidationError, such as a caller's Extended hook, report
+// as "extended".
+func ruleFromError(err error) string {
+	if err == nil {
+		return ""
+	}
+	ve, ok := err.(*ValidationError)
+	if !ok {
+		return "extended"
+	}
+	_, reason, found := strings.Cut(ve.Code, ".")
+	if !found {
+		return ve.Code
+	}
+	switch reason {
+	case "required":
+		return "null"
+	case "empty", "zero":
+		return "empty"
+	case "too_short", "before_min", "below_min":
+		return "min"
+	case "too_long", "after_max", "above_max":
+		return "max"
+	case "has_spaces":
+		return "nospaces"
+	case "not_multiple":
+		return "multipleof"
+	default:
+		return reason
+	}
+}
+
+// parseValidateTag splits a `validate:"min=3,max=20,nospaces"`-style tag
+// into its comma-separated key/value parts. Bare keys (no "=") are recorded
+// with the value "true" so they can be used as flags.
+func parseValidateTag(tag string) map[string]string {
+	out := map[string]string{}
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if idx := strings.Index(part, "="); idx >= 0 {
+			out[part[:idx]] = part[idx+1:]
+			continue
+		}
+		out[part] = "true"
+	}
+	return out
+}
+
+func stringOptionsFor(sf reflect.StructField, fs *FieldSchema) *StringValidationOptions {
+	if fs != nil {
+		return fs.String
+	}
+	tag, ok := sf.Tag.Lookup("validate")
+	if !ok {
+		return nil
+	}
+	vals := parseValidateTag(tag)
+	opts := &StringValidationOptions{}
+	_, opts.Empty = vals["empty"]
+	_, opts.Null = vals["null"]
+	_, opts.NoSpaces = vals["nospaces"]
+	if v, ok := vals["min"]; ok {
+		opts.Min, _ = strconv.Atoi(v)
+	}
+	if v, ok := vals["max"]; ok {
+		opts.Max, _ = strconv.Atoi(v)
+	}
+	return opts
+}
+
+func intOptionsFor(sf reflect.StructField, fs *FieldSchema) *NumericValidationOptions[int64] {
+	if fs != nil {
+		return fs.Int
+	}
+	tag, ok := sf.Tag.Lookup("validate")
+	if !ok {
+		return nil
+	}
+	vals := parseValidateTag(tag)
+	opts := &NumericValidationOptions[int64]{}
+	_, opts.Empty = vals["empty"]
+	_, opts.Null = vals["null"]
+	if v, ok := vals["min"]; ok {
+		opts.Min, _ = strconv.ParseInt(v, 10, 64)
+		opts.HasMin = true
+	}
+	if v, ok := vals["max"]; ok {
+		opts.Max, _ = strconv.ParseInt(v, 10, 64)
+		opts.HasMax = true
+	}
+	return opts
+}
+
+func uintOptionsFor(sf reflect.StructField, fs *FieldSchema) *NumericValidationOptions[uint64] {
+	if fs != nil {
+		return fs.Uint
+	}
+	tag, ok := sf.Tag.Lookup("validate")
+	if !ok {
+		return nil
+	}
+	vals := parseValidateTag(tag)
+	opts := &NumericValidationOptions[uint64]{}
+	_, opts.Empty = vals["empty"]
+	_, opts.Null = vals["null"]
+	if v, ok := vals["min"]; ok {
+		opts.Min, _ = strconv.ParseUint(v, 10, 64)
+		opts.HasMin = true
+	}
+	if v, ok := vals["max"]; ok {
+		opts.Max, _ = strconv.ParseUint(v, 10, 64)
+		opts.HasMax = true
+	}
+	return opts
+}
+
+func floatOptionsFor(sf reflect.StructField, fs *FieldSchema) *NumericValidationOptions[float64] {
+	if fs != nil {
+		return fs.Float
+	}
+	tag, ok := sf.Tag.Lookup("validate")
+	if !ok {
+		return nil
+	}
+	vals := parseValidateTag(tag)
+	opts := &NumericValidationOptions[float64]{}
+	_, opts.Empty = vals["empty"]
+	_, opts.Null = vals["null"]
+	if v, ok := vals["min"]; ok {
+		opts.Min, _ = strconv.ParseFloat(v, 64)
+		opts.HasMin = true
+	}
+	if v, ok := vals["max"]; ok {
+		opts.Max, _ = strconv.ParseFloat(v, 64)
+		opts.HasMax = true
+	}
+	return opts
+}
+
+func timeOptionsFor(sf reflect.StructField, fs *FieldSchema) *TimeValidationOptions {
+	if fs != nil {
+		return fs.Time
+	}
+	tag, ok := sf.Tag.Lookup("validate")
+	if !ok {
+		return nil
+	}
+	vals := parseValidateTag(tag)
+	opts := &TimeValidationOptions{}
+	_, opts.Empty = vals["empty"]
+	_, opts.Null = vals["null"]
+	_, opts.DateOnly = vals["dateonly"]
+	return opts
+}
+
+func durationOptionsFor(sf reflect.StructField, fs *FieldSchema) *DurationValidationOptions {
+	if fs != nil {
+		return fs.Duration
+	}
+	tag, ok := sf.Tag.Lookup("validate")
+	if !ok {
+		return nil
+	}
+	vals := parseValidateTag(tag)
+	opts := &DurationValidationOptions{}
+	_, opts.Empty = vals["empty"]
+	_, opts.Null = vals["null"]
+	_, opts.NonNegative = vals["nonnegative"]
+	if v, ok := vals["min"]; ok {
+		if d, err := time.ParseDuration(v); err == nil {
+			opts.Min = d
+			opts.HasMin = true
+		}
+	}
+	if v, ok := vals["max"]; ok {
+		if d, err := time.ParseDuration(v); err == nil {
+			opts.Max = d
+			opts.HasMax = true
+		}
+	}
+	return opts
+}
+
+func decimalOptionsFor(sf reflect.StructField, fs *FieldSchema) *DecimalValidationOptions {
+	if fs != nil {
+		return fs.Decimal
+	}
+	tag, ok := sf.Tag.Lookup("validate")
+	if !ok {
+		return nil
+	}
+	vals := parseValidateTag(tag)
+	opts := &DecimalValidationOptions{}
+	_, opts.Empty = vals["empty"]
+	_, opts.Null = vals["null"]
+	if v, ok := vals["min"]; ok {
+		if d, err := ssd.NewFromString(v); err == nil {
+			opts.Min = &d
+		}
+	}
+	if v, ok := vals["max"]; ok {
+		if d, err := ssd.NewFromString(v); err == nil {
+			opts.Max = &d
+		}
+	}
+	return opts
+}
@@ -0,0 +1,128 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	ssd "github.com/shopspring/decimal"
+)
+
+type structTestAddress struct {
+	Zip string
+}
+
+type structTestItem struct {
+	Name string
+}
+
+type structTestSubject struct {
+	Name    string
+	Address structTestAddress
+	Items   []structTestItem
+	Tags    map[string]string
+	Parent  *structTestAddress
+}
+
+func TestValidateStructWalksNestedFields(t *testing.T) {
+	v := structTestSubject{
+		Name:    "",
+		Address: structTestAddress{Zip: ""},
+		Items:   []structTestItem{{Name: ""}, {Name: "ok"}},
+		Tags:    map[string]string{"owner": ""},
+		Parent:  &structTestAddress{Zip: ""},
+	}
+	schema := &StructSchema{
+		Fields: map[string]FieldSchema{
+			"Name":         {String: &StringValidationOptions{}},
+			"Address.Zip":  {String: &StringValidationOptions{}},
+			"Items.0.Name": {String: &StringValidationOptions{}},
+			"Items.1.Name": {String: &StringValidationOptions{}},
+			"Tags":         {String: &StringValidationOptions{}},
+			"Parent.Zip":   {String: &StringValidationOptions{}},
+		},
+	}
+
+	errs := ValidateStruct(&v, schema)
+
+	paths := map[string]bool{}
+	for _, fe := range errs {
+		paths[fe.Field] = true
+	}
+
+	for _, want := range []string{"Name", "Address.Zip", "Items.0.Name", "Tags.owner", "Parent.Zip"} {
+		if !paths[want] {
+			t.Errorf("expected a field error for %q, got errors: %v", want, errs)
+		}
+	}
+	if paths["Items.1.Name"] {
+		t.Errorf("did not expect a field error for Items.1.Name, got errors: %v", errs)
+	}
+}
+
+func TestValidateStructNilPointerSkipsRecursion(t *testing.T) {
+	v := structTestSubject{Parent: nil}
+	schema := &StructSchema{
+		Fields: map[string]FieldSchema{
+			"Parent.Zip": {String: &StringValidationOptions{}},
+		},
+	}
+
+	errs := ValidateStruct(&v, schema)
+	for _, fe := range errs {
+		if fe.Field == "Parent.Zip" {
+			t.Fatalf("did not expect a field error on a nil pointer's nested field, got: %v", errs)
+		}
+	}
+}
+
+type structTestRequiredPointers struct {
+	Str      *string
+	Int      *int64
+	Uint     *uint64
+	Float    *float64
+	Time     *time.Time
+	Decimal  *ssd.Decimal
+	Duration *time.Duration
+}
+
+func TestValidateStructRequiredNilPointerIsEnforced(t *testing.T) {
+	v := structTestRequiredPointers{}
+	schema := &StructSchema{
+		Fields: map[string]FieldSchema{
+			"Str":      {String: &StringValidationOptions{}},
+			"Int":      {Int: &NumericValidationOptions[int64]{}},
+			"Uint":     {Uint: &NumericValidationOptions[uint64]{}},
+			"Float":    {Float: &NumericValidationOptions[float64]{}},
+			"Time":     {Time: &TimeValidationOptions{}},
+			"Decimal":  {Decimal: &DecimalValidationOptions{}},
+			"Duration": {Duration: &DurationValidationOptions{}},
+		},
+	}
+
+	errs := ValidateStruct(&v, schema)
+
+	paths := map[string]bool{}
+	for _, fe := range errs {
+		if fe.Rule != "null" {
+			t.Errorf("expected rule %q for nil %s, got %q", "null", fe.Field, fe.Rule)
+		}
+		paths[fe.Field] = true
+	}
+	for _, want := range []string{"Str", "Int", "Uint", "Float", "Time", "Decimal", "Duration"} {
+		if !paths[want] {
+			t.Errorf("expected a required (null) field error for nil pointer field %q, got errors: %v", want, errs)
+		}
+	}
+}
+
+func TestValidateStructTagFallback(t *testing.T) {
+	type tagged struct {
+		Name string `validate:"min=3"`
+	}
+	v := tagged{Name: "ab"}
+
+	errs := ValidateStruct(&v, nil)
+	if len(errs) != 1 || errs[0].Field != "Name" || errs[0].Rule != "min" {
+		t.Fatalf("expected a single min rule error on Name, got: %v", errs)
+	}
+}
@@ -0,0 +1,149 @@
+package validation
+
+import (
+	"fmt"
+	"sync"
+)
+
+type (
+	// ValidationError is a structured, localizable validation failure
+	// returned by ValidateString/ValidateNumeric/ValidateTime/
+	// ValidateDecimal/ValidateEmail. Code is a stable, machine-parseable
+	// identifier (e.g. "string.too_short"); Params carries the values
+	// substituted into the rendered message (e.g. {"min": 8, "actual": 3}).
+	ValidationError struct {
+		Code   string
+		Params map[string]any
+	}
+
+	// Translator renders a ValidationError's Code/Params into a
+	// human-readable message.
+	Translator interface {
+		Translate(code string, params map[string]any) string
+	}
+)
+
+var (
+	translatorsMu sync.RWMutex
+	translators   = map[string]Translator{
+		"en": englishTranslator{},
+	}
+	currentLang = "en"
+)
+
+// RegisterTranslator registers t as the Translator used for lang, replacing
+// any Translator previously registered for it. An English Translator is
+// registered under "en" by default.
+func RegisterTranslator(lang string, t Translator) {
+	translatorsMu.Lock()
+	defer translatorsMu.Unlock()
+	translators[lang] = t
+}
+
+// SetLanguage changes the language (*ValidationError).Error() renders in.
+// It defaults to "en". Passing a lang with no registered Translator falls
+// back to "en".
+func SetLanguage(lang string) {
+	translatorsMu.Lock()
+	defer translatorsMu.Unlock()
+	currentLang = lang
+}
+
+// Error renders e via the Translator registered for the current language,
+// falling back to the Translator registered under "en".
+func (e *ValidationError) Error() string {
+	translatorsMu.RLock()
+	t, ok := translators[currentLang]
+	if !ok {
+		t = translators["en"]
+	}
+	translatorsMu.RUnlock()
+	return t.Translate(e.Code, e.Params)
+}
+
+type englishTranslator struct{}
+
+func (englishTranslator) Translate(code string, params map[string]any) string {
+	switch code {
+	case "email.invalid":
+		return "is an invalid email address"
+	case "string.required":
+		return "must be provided (nil)"
+	case "string.empty":
+		return "must be provided (empty)"
+	case "string.too_short":
+		return fmt.Sprintf("is shorter than %v characters", params["min"])
+	case "string.too_long":
+		return fmt.Sprintf("is longer than %v characters", params["max"])
+	case "string.has_spaces":
+		return "contains spaces"
+	case "time.required":
+		return "must be provided (nil)"
+	case "time.empty":
+		return "must be provided (empty)"
+	case "time.before_min":
+		return fmt.Sprintf("is earlier than %v minimum time", params["min"])
+	case "time.after_max":
+		return fmt.Sprintf("is later than %v maximum time", params["max"])
+	case "numeric.required":
+		return "must be provided (nil)"
+	case "numeric.zero":
+		return "must be provided (empty)"
+	case "numeric.below_min":
+		return fmt.Sprintf("is lesser than %v minimum value", params["min"])
+	case "numeric.above_max":
+		return fmt.Sprintf("is greater than %v maximum value", params["max"])
+	case "numeric.not_multiple":
+		return fmt.Sprintf("is not a multiple of %v", params["of"])
+	case "decimal.required":
+		return "must be provided (nil)"
+	case "decimal.zero":
+		return "must be provided (empty)"
+	case "decimal.below_min":
+		return fmt.Sprintf("is lesser than %v minimum value", params["min"])
+	case "decimal.above_max":
+		return fmt.Sprintf("is greater than %v maximum value", params["max"])
+	case "decimal.not_multiple":
+		return fmt.Sprintf("is not a multiple of %v", params["of"])
+	case "duration.required":
+		return "must be provided (nil)"
+	case "duration.empty":
+		return "must be provided (empty)"
+	case "duration.negative":
+		return "must not be negative"
+	case "duration.below_min":
+		return fmt.Sprintf("is shorter than %v minimum duration", params["min"])
+	case "duration.above_max":
+		return fmt.Sprintf("is longer than %v maximum duration", params["max"])
+	case "duration.invalid":
+		return fmt.Sprintf("is not a valid duration: %v", params["cause"])
+	case "condition.required":
+		return fmt.Sprintf("is required by %v", params["kind"])
+	case "condition.excluded":
+		return fmt.Sprintf("is excluded by %v", params["kind"])
+	case "rule.type_mismatch":
+		return fmt.Sprintf("must be a %v", params["expected"])
+	case "rule.nonempty":
+		return "must not be empty"
+	case "rule.minlength":
+		return fmt.Sprintf("is shorter than %v characters", params["min"])
+	case "rule.maxlength":
+		return fmt.Sprintf("is longer than %v characters", params["max"])
+	case "rule.matches":
+		return "does not match the expected pattern"
+	case "rule.url":
+		return "is an invalid URL"
+	case "rule.oneof":
+		return fmt.Sprintf("must be one of %v", params["options"])
+	case "rule.notblank":
+		return "must not be blank"
+	case "rule.greaterthan":
+		return fmt.Sprintf("must be greater than %v", params["min"])
+	case "rule.between":
+		return fmt.Sprintf("must be between %v and %v", params["min"], params["max"])
+	case "rule.positivedecimal":
+		return "must be a positive decimal"
+	default:
+		return code
+	}
+}
@@ -0,0 +1,55 @@
+package validation
+
+import "testing"
+
+func TestValidationErrorDefaultsToEnglish(t *testing.T) {
+	err := &ValidationError{Code: "string.required"}
+	if got, want := err.Error(), "must be provided (nil)"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidationErrorUnknownCodeFallsBackToCode(t *testing.T) {
+	err := &ValidationError{Code: "not.a.real.code"}
+	if got, want := err.Error(), "not.a.real.code"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+type stubTranslator struct{}
+
+func (stubTranslator) Translate(code string, params map[string]any) string {
+	return "stub:" + code
+}
+
+func TestRegisterTranslatorAndSetLanguage(t *testing.T) {
+	t.Cleanup(func() {
+		translatorsMu.Lock()
+		delete(translators, "xx")
+		currentLang = "en"
+		translatorsMu.Unlock()
+	})
+
+	RegisterTranslator("xx", stubTranslator{})
+	SetLanguage("xx")
+
+	err := &ValidationError{Code: "string.required"}
+	if got, want := err.Error(), "stub:string.required"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestSetLanguageFallsBackToEnglishForUnregisteredLanguage(t *testing.T) {
+	t.Cleanup(func() {
+		translatorsMu.Lock()
+		currentLang = "en"
+		translatorsMu.Unlock()
+	})
+
+	SetLanguage("zz-not-registered")
+
+	err := &ValidationError{Code: "string.empty"}
+	if got, want := err.Error(), "must be provided (empty)"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
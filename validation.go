@@ -5,7 +5,7 @@
 package validation
 
 import (
-	"fmt"
+	"math"
 	"regexp"
 	"strings"
 	"time"
@@ -28,6 +28,9 @@ type (
 		Max      int  // Maximum length. Default: 0
 		NoSpaces bool // Do not allow spaces in the string. Default: false. Setting to true will raise an error if the string has spaces
 		Extended []func(value *string) error
+		// Conditions are sibling-field predicates (RequiredIf, ExcludedUnless, etc.)
+		// evaluated only by ValidateStruct, which has access to the parent struct.
+		Conditions []Condition
 	}
 	TimeValidationOptions struct {
 		Null     bool       // Allow null. Default: false, will raise an error if the time is null
@@ -36,35 +39,75 @@ type (
 		Max      *time.Time // Maximum time. Default: nil
 		DateOnly bool       // Compare dates only. Default: false
 		Extended []func(value *time.Time) error
+		// Conditions are sibling-field predicates (RequiredIf, ExcludedUnless, etc.)
+		// evaluated only by ValidateStruct, which has access to the parent struct.
+		Conditions []Condition
 	}
 	NumericValidationOptions[T NumericConstraint] struct {
-		Null     bool // Allow null. Default: false, will raise an error if the time is null
-		Empty    bool // Allow zero time Default: false, will raise an error if the time is zero
-		Min      T    // Minimum time. Default: nil
-		Max      T    // Maximum time. Default: nil
-		Extended []func(value *T) error
+		Null  bool // Allow null. Default: false, will raise an error if the value is null
+		Empty bool // Allow zero value. Default: false, will raise an error if the value is zero
+		Min   T    // Minimum value. Default: 0
+		Max   T    // Maximum value. Default: 0
+		// HasMin/HasMax activate Min/Max, including zero and negative
+		// bounds. Without them, Min/Max are not checked, unless
+		// LegacyZeroMeansUnset is set (see below).
+		HasMin       bool
+		HasMax       bool
+		MinExclusive bool // Min is an exclusive bound (value must be > Min, not >=). Default: false
+		MaxExclusive bool // Max is an exclusive bound (value must be < Max, not <=). Default: false
+		MultipleOf   T    // Value must be a multiple of this if non-zero. Default: 0 (unchecked)
+		Extended     []func(value *T) error
+		// Conditions are sibling-field predicates (RequiredIf, ExcludedUnless, etc.)
+		// evaluated only by ValidateStruct, which has access to the parent struct.
+		Conditions []Condition
+		// Deprecated: LegacyZeroMeansUnset restores the pre-fix behavior
+		// where Min/Max only activated when greater than zero, silently
+		// ignoring zero and negative bounds, and HasMin/HasMax were not
+		// consulted. Set it while migrating call sites to HasMin/HasMax;
+		// this flag will be removed in a future release.
+		LegacyZeroMeansUnset bool
 	}
 	DecimalValidationOptions struct {
-		Null     bool         // Allow null. Default: false, will raise an error if the decimal is null
-		Empty    bool         // Allow zero decimal. Default: false, will raise an error if the decimal is zero
-		Min      *ssd.Decimal // Minimum decimal value. Default: nil
-		Max      *ssd.Decimal // Maximum decimal value. Default: nil
-		Extended []func(value *ssd.Decimal) error
+		Null         bool         // Allow null. Default: false, will raise an error if the decimal is null
+		Empty        bool         // Allow zero decimal. Default: false, will raise an error if the decimal is zero
+		Min          *ssd.Decimal // Minimum decimal value. Default: nil
+		Max          *ssd.Decimal // Maximum decimal value. Default: nil
+		MinExclusive bool         // Min is an exclusive bound (value must be > Min, not >=). Default: false
+		MaxExclusive bool         // Max is an exclusive bound (value must be < Max, not <=). Default: false
+		MultipleOf   *ssd.Decimal // Value must be a multiple of this if non-nil. Default: nil (unchecked)
+		Extended     []func(value *ssd.Decimal) error
+		// Conditions are sibling-field predicates (RequiredIf, ExcludedUnless, etc.)
+		// evaluated only by ValidateStruct, which has access to the parent struct.
+		Conditions []Condition
+		// Deprecated: LegacyZeroMeansUnset restores the pre-fix behavior
+		// where Min/Max only activated when greater than zero, silently
+		// ignoring a zero or negative bound even though Min/Max are
+		// already pointers. Set it while migrating away from zero/negative
+		// Min/Max values that relied on being ignored; this flag will be
+		// removed in a future release.
+		LegacyZeroMeansUnset bool
 	}
 )
 
 // ValidateEmail validates an e-mail address
 func ValidateEmail(email *string) error {
 	if email == nil || *email == "" {
-		return fmt.Errorf("is an invalid email address")
+		return &ValidationError{Code: "email.invalid", Params: map[string]any{"actual": emailValue(email)}}
 	}
 	re := regexp.MustCompile(EMAIL_PATTERN)
 	if !re.MatchString(*email) {
-		return fmt.Errorf("is an invalid email address")
+		return &ValidationError{Code: "email.invalid", Params: map[string]any{"actual": *email}}
 	}
 	return nil
 }
 
+func emailValue(email *string) any {
+	if email == nil {
+		return nil
+	}
+	return *email
+}
+
 // ValidateString validates an input string against the string validation options
 func ValidateString(value *string, opts *StringValidationOptions) error {
 
@@ -76,25 +119,25 @@ func ValidateString(value *string, opts *StringValidationOptions) error {
 	}
 	if value == nil {
 		if !opts.Null {
-			return fmt.Errorf("must be provided (nil)")
+			return &ValidationError{Code: "string.required"}
 		}
 		return nil
 	}
 	ln := len([]rune(*value))
 	if ln == 0 {
 		if !opts.Empty {
-			return fmt.Errorf("must be provided (empty)")
+			return &ValidationError{Code: "string.empty"}
 		}
 		return nil
 	}
 	if opts.Min > 0 && ln < opts.Min {
-		return fmt.Errorf("is shorter than %d characters", opts.Min)
+		return &ValidationError{Code: "string.too_short", Params: map[string]any{"min": opts.Min, "actual": ln}}
 	}
 	if opts.Max > 0 && ln > opts.Max {
-		return fmt.Errorf("is longer than %d characters", opts.Max)
+		return &ValidationError{Code: "string.too_long", Params: map[string]any{"max": opts.Max, "actual": ln}}
 	}
 	if opts.NoSpaces && strings.Contains(*value, " ") {
-		return fmt.Errorf("contains spaces")
+		return &ValidationError{Code: "string.has_spaces"}
 	}
 	for _, f := range opts.Extended {
 		if err := f(value); err != nil {
@@ -115,13 +158,13 @@ func ValidateTime(value *time.Time, opts *TimeValidationOptions) error {
 	}
 	if value == nil {
 		if !opts.Null {
-			return fmt.Errorf("must be provided (nil)")
+			return &ValidationError{Code: "time.required"}
 		}
 		return nil
 	}
 	if value.IsZero() {
 		if !opts.Empty {
-			return fmt.Errorf("must be provided (empty)")
+			return &ValidationError{Code: "time.empty"}
 		}
 		return nil
 	}
@@ -140,11 +183,11 @@ func ValidateTime(value *time.Time, opts *TimeValidationOptions) error {
 	}
 
 	if opts.Min != nil && value.Before(*opts.Min) {
-		return fmt.Errorf("is earlier than %s minimum time", opts.Min)
+		return &ValidationError{Code: "time.before_min", Params: map[string]any{"min": *opts.Min, "actual": *value}}
 	}
 
 	if opts.Max != nil && value.After(*opts.Max) {
-		return fmt.Errorf("is later than %s maximum time", opts.Max)
+		return &ValidationError{Code: "time.after_max", Params: map[string]any{"max": *opts.Max, "actual": *value}}
 	}
 
 	for _, f := range opts.Extended {
@@ -172,20 +215,32 @@ func ValidateNumeric[T NumericConstraint](value *T, opts *NumericValidationOptio
 	}
 	if value == nil {
 		if !opts.Null {
-			return fmt.Errorf("must be provided (nil)")
+			return &ValidationError{Code: "numeric.required"}
 		}
 		return nil
 	}
 	if *value == 0 {
 		if !opts.Empty {
-			return fmt.Errorf("must be provided (empty)")
+			return &ValidationError{Code: "numeric.zero"}
 		}
 	}
-	if opts.Min > 0 && *value < opts.Min {
-		return fmt.Errorf("is lesser than %v minimum value", opts.Min)
+
+	minActive, maxActive := opts.HasMin, opts.HasMax
+	if opts.LegacyZeroMeansUnset {
+		minActive, maxActive = opts.Min > 0, opts.Max > 0
+	}
+	if minActive {
+		if (opts.MinExclusive && *value <= opts.Min) || (!opts.MinExclusive && *value < opts.Min) {
+			return &ValidationError{Code: "numeric.below_min", Params: map[string]any{"min": opts.Min, "actual": *value, "exclusive": opts.MinExclusive}}
+		}
 	}
-	if opts.Max > 0 && *value > opts.Max {
-		return fmt.Errorf("is greater than %v maximum value", opts.Max)
+	if maxActive {
+		if (opts.MaxExclusive && *value >= opts.Max) || (!opts.MaxExclusive && *value > opts.Max) {
+			return &ValidationError{Code: "numeric.above_max", Params: map[string]any{"max": opts.Max, "actual": *value, "exclusive": opts.MaxExclusive}}
+		}
+	}
+	if opts.MultipleOf != 0 && !isMultipleOf(*value, opts.MultipleOf) {
+		return &ValidationError{Code: "numeric.not_multiple", Params: map[string]any{"of": opts.MultipleOf, "actual": *value}}
 	}
 	for _, f := range opts.Extended {
 		if err := f(value); err != nil {
@@ -195,6 +250,20 @@ func ValidateNumeric[T NumericConstraint](value *T, opts *NumericValidationOptio
 	return nil
 }
 
+// isMultipleOf reports whether value is an integer multiple of of.
+func isMultipleOf[T NumericConstraint](value, of T) bool {
+	if of == 0 {
+		return true
+	}
+	q := float64(value) / float64(of)
+	rounded := math.Round(q)
+	// float64 division accumulates rounding error proportional to the
+	// magnitude of the quotient (e.g. 0.3/0.1 is ~2.9999999999999996, not
+	// exactly 3), so compare against a tolerance instead of exact equality.
+	const epsilon = 1e-9
+	return math.Abs(q-rounded) <= epsilon*math.Max(1, math.Abs(q))
+}
+
 // ValidateDecimal validates a decimal input against decimal validation options
 func ValidateDecimal(value *ssd.Decimal, opts *DecimalValidationOptions) error {
 
@@ -205,21 +274,28 @@ func ValidateDecimal(value *ssd.Decimal, opts *DecimalValidationOptions) error {
 	}
 	if value == nil {
 		if !opts.Null {
-			return fmt.Errorf("must be provided (nil)")
+			return &ValidationError{Code: "decimal.required"}
 		}
 		return nil
 	}
 	if value.IsZero() {
 		if !opts.Empty {
-			return fmt.Errorf("must be provided (empty)")
+			return &ValidationError{Code: "decimal.zero"}
 		}
 	}
-	zero := ssd.NewFromInt(0)
-	if opts.Min != nil && opts.Min.GreaterThan(zero) && value.LessThan(*opts.Min) {
-		return fmt.Errorf("is lesser than %v minimum value", *opts.Min)
+
+	if opts.Min != nil && (!opts.LegacyZeroMeansUnset || opts.Min.GreaterThan(ssd.NewFromInt(0))) {
+		if (opts.MinExclusive && !value.GreaterThan(*opts.Min)) || (!opts.MinExclusive && value.LessThan(*opts.Min)) {
+			return &ValidationError{Code: "decimal.below_min", Params: map[string]any{"min": *opts.Min, "actual": *value, "exclusive": opts.MinExclusive}}
+		}
+	}
+	if opts.Max != nil && (!opts.LegacyZeroMeansUnset || opts.Max.GreaterThan(ssd.NewFromInt(0))) {
+		if (opts.MaxExclusive && !value.LessThan(*opts.Max)) || (!opts.MaxExclusive && value.GreaterThan(*opts.Max)) {
+			return &ValidationError{Code: "decimal.above_max", Params: map[string]any{"max": *opts.Max, "actual": *value, "exclusive": opts.MaxExclusive}}
+		}
 	}
-	if opts.Max != nil && opts.Max.GreaterThan(zero) && value.GreaterThan(*opts.Max) {
-		return fmt.Errorf("is greater than %v maximum value", *opts.Max)
+	if opts.MultipleOf != nil && !opts.MultipleOf.IsZero() && !value.Mod(*opts.MultipleOf).IsZero() {
+		return &ValidationError{Code: "decimal.not_multiple", Params: map[string]any{"of": *opts.MultipleOf, "actual": *value}}
 	}
 	for _, f := range opts.Extended {
 		if err := f(value); err != nil {
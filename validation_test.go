@@ -0,0 +1,29 @@
+package validation
+
+import "testing"
+
+func TestValidateNumericMultipleOfFloatTolerance(t *testing.T) {
+	value := 0.3
+	opts := &NumericValidationOptions[float64]{Empty: true, MultipleOf: 0.1}
+	if err := ValidateNumeric(&value, opts); err != nil {
+		t.Fatalf("expected 0.3 to validate as a multiple of 0.1, got: %v", err)
+	}
+
+	bad := 0.35
+	if err := ValidateNumeric(&bad, opts); err == nil {
+		t.Fatal("expected 0.35 to fail the MultipleOf 0.1 check")
+	}
+}
+
+func TestValidateNumericMultipleOfIntegers(t *testing.T) {
+	value := int64(9)
+	opts := &NumericValidationOptions[int64]{Empty: true, MultipleOf: 3}
+	if err := ValidateNumeric(&value, opts); err != nil {
+		t.Fatalf("expected 9 to validate as a multiple of 3, got: %v", err)
+	}
+
+	bad := int64(10)
+	if err := ValidateNumeric(&bad, opts); err == nil {
+		t.Fatal("expected 10 to fail the MultipleOf 3 check")
+	}
+}